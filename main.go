@@ -0,0 +1,178 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/maliasadi/go-pcinfo/pkg/disk"
+	"github.com/maliasadi/go-pcinfo/pkg/host"
+	"github.com/maliasadi/go-pcinfo/pkg/pcinfo"
+)
+
+func printHostName() {
+	hostN, err := pcinfo.Hostname()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("hostname:\t%v\n", hostN)
+}
+
+func printOSName() {
+	goos, goarch := pcinfo.OS()
+	fmt.Printf("OS name:\t%v\n", goos)
+	fmt.Printf("OS arch:\t%v\n", goarch)
+}
+
+func printSysInfo() {
+	sys_obj, err := pcinfo.Sysinfo()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("sys uptime:\t%d\n", sys_obj.UpTime)
+	fmt.Printf("sys load avg:\t%2.2f, %2.2f, %2.2f\n",
+		sys_obj.Loads[0], sys_obj.Loads[1], sys_obj.Loads[2])
+	fmt.Printf("sys totalRam:\t%d MB\n", sys_obj.TotalRam)
+	fmt.Printf("sys freeRam:\t%d MB\n", sys_obj.FreeRam)
+	fmt.Printf("sys sharedRam:\t%d MB\n", sys_obj.SharedRam)
+	fmt.Printf("sys bufferRam:\t%d MB\n", sys_obj.BufferRam)
+	fmt.Printf("sys totalSwap:\t%d MB\n", sys_obj.TotalSwap)
+	fmt.Printf("sys freeSwap:\t%d MB\n", sys_obj.FreeSwap)
+	fmt.Printf("sys totalHigh:\t%d MB\n", sys_obj.TotalHigh)
+	fmt.Printf("sys freeHigh:\t%d MB\n", sys_obj.FreeHigh)
+	fmt.Printf("sys procs:\t%d\n", sys_obj.Procs)
+
+	system, role, err := host.Virtualization()
+	if err == nil && system != "" {
+		fmt.Printf("sys virtualization:\t%s (%s)\n", system, role)
+		if limits, err := host.CgroupLimits(); err == nil {
+			if limits.CPUQuota > 0 {
+				fmt.Printf("sys cgroup cpu quota:\t%.2f cores\n", limits.CPUQuota)
+			}
+			if limits.MemoryLimitBytes > 0 {
+				fmt.Printf("sys cgroup mem limit:\t%d MB\n", limits.MemoryLimitBytes/1024/1024)
+			}
+		}
+	}
+}
+
+func printCPUInfo() {
+	cpu_info, err := pcinfo.CPUInfo()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < len(cpu_info); i++ {
+		fmt.Printf("cpuID:\t%d\n", cpu_info[i].CPU)
+		fmt.Printf("--vendorID:\t%s\n", cpu_info[i].VendorID)
+		fmt.Printf("--family:\t%s\n", cpu_info[i].Family)
+		fmt.Printf("--model:\t%s\n", cpu_info[i].Model)
+		fmt.Printf("--stepping:\t%d\n", cpu_info[i].Stepping)
+		fmt.Printf("--physicalID:\t%s\n", cpu_info[i].PhysicalID)
+		fmt.Printf("--coreID:\t%s\n", cpu_info[i].CoreID)
+		fmt.Printf("--cores:\t%d\n", cpu_info[i].Cores)
+		fmt.Printf("--modelName:\t%s\n", cpu_info[i].ModelName)
+		fmt.Printf("--MHz:\t\t%g\n", cpu_info[i].Mhz)
+		fmt.Printf("--facheSize:\t%d\n", cpu_info[i].CacheSize)
+		fmt.Printf("--flags:\t%v\n", cpu_info[i].Flags)
+		fmt.Printf("--Microcode:\t%s\n", cpu_info[i].Microcode)
+		if cpu_info[i].PCores != 0 || cpu_info[i].ECores != 0 {
+			fmt.Printf("--pCores:\t%d\n", cpu_info[i].PCores)
+			fmt.Printf("--eCores:\t%d\n", cpu_info[i].ECores)
+			fmt.Printf("--eMHz:\t\t%g\n", cpu_info[i].EMhz)
+		}
+	}
+}
+
+func printNetworkInterface() {
+	ifaces, err := pcinfo.Interfaces()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("interfaces:\t%v\n", ifaces[0])
+	for i := 1; i < len(ifaces); i++ {
+		fmt.Printf("\t\t%v\n", ifaces[i])
+	}
+}
+
+func printDisks() {
+	disks, err := disk.Disks()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, d := range disks {
+		fmt.Printf("device:\t\t%s\n", d.Device)
+		fmt.Printf("--mountpoint:\t%s\n", d.Mountpoint)
+		fmt.Printf("--fstype:\t%s\n", d.Fstype)
+		fmt.Printf("--total:\t%d MB\n", d.Total/1024/1024)
+		fmt.Printf("--used:\t\t%d MB (%.1f%%)\n", d.Used/1024/1024, d.UsedPercent)
+		fmt.Printf("--free:\t\t%d MB\n", d.Free/1024/1024)
+		fmt.Printf("--readBytes:\t%d\n", d.ReadBytes)
+		fmt.Printf("--writeBytes:\t%d\n", d.WriteBytes)
+		if d.SMART == nil {
+			fmt.Printf("--smart:\tunavailable (requires root, or an unsupported bus/virtual disk)\n")
+			continue
+		}
+		fmt.Printf("--smart healthy:\t%v\n", d.SMART.Healthy)
+		fmt.Printf("--smart temperature:\t%d C\n", d.SMART.TemperatureC)
+		fmt.Printf("--smart powerOnHours:\t%d\n", d.SMART.PowerOnHours)
+		fmt.Printf("--smart powerCycles:\t%d\n", d.SMART.PowerCycles)
+		fmt.Printf("--smart reallocatedSectors:\t%d\n", d.SMART.ReallocatedSectors)
+		fmt.Printf("--smart wearLeveling:\t%d%%\n", d.SMART.WearLeveling)
+	}
+}
+
+func main() {
+
+	var hn = flag.Bool("hostname", false, "if 'hostname=true' => print hostname")
+	var OS = flag.Bool("os", false, "if 'os=true' => print operating system info")
+	var cpu = flag.Bool("cpu", false, "if 'cpu=true' => print cpu info")
+	var netw = flag.Bool("network", false, "if 'network=true' => print network interfaces info")
+	var dsk = flag.Bool("disk", false, "if 'disk=true' => print disk usage and SMART health")
+	var watchMode = flag.Bool("watch", false, "if 'watch=true' => stream metrics continuously instead of printing once")
+	var interval = flag.Duration("interval", 2*time.Second, "sampling interval for -watch")
+	var format = flag.String("format", "json", "frame format for -watch (only \"json\" is supported)")
+	var listen = flag.String("listen", "", "if set, -watch serves frames to a single TCP client on this address instead of stdout")
+	var serve = flag.String("serve", "", "if set, serve Prometheus metrics on this address (e.g. ':9101') instead of printing once")
+
+	flag.Parse()
+
+	if *serve != "" {
+		log.Fatal(runServe(*serve))
+	}
+
+	if *watchMode {
+		if err := runWatch(*interval, *format, *listen); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Println(len(os.Args))
+	if len(os.Args) == 1 {
+		printHostName()
+		printNetworkInterface()
+		printOSName()
+		printSysInfo()
+		printCPUInfo()
+		return
+	}
+
+	if *hn {
+		printHostName()
+	}
+	if *OS {
+		printSysInfo()
+		printCPUInfo()
+	}
+	if *cpu {
+		printCPUInfo()
+	}
+	if *netw {
+		printNetworkInterface()
+	}
+	if *dsk {
+		printDisks()
+	}
+}
@@ -0,0 +1,82 @@
+// Package disk reports mounted filesystem usage and, when available,
+// SMART health data for the underlying block devices.
+package disk
+
+import (
+	"path/filepath"
+
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+// SMARTInfo holds the subset of SMART attributes that matter for a quick
+// health check. It is nil on a DiskInfo whenever SMART data could not be
+// read (non-root, virtual disk, or an unsupported bus).
+type SMARTInfo struct {
+	TemperatureC       uint64 // Device temperature in Celsius
+	PowerOnHours       uint64 // Hours the device has spent powered on
+	PowerCycles        uint64 // Number of power cycles
+	ReallocatedSectors uint64 // SATA: reallocated sector count (attribute 5)
+	WearLeveling       uint64 // Remaining endurance, 0-100 (SSD/NVMe only)
+	Healthy            bool   // Overall SMART health verdict
+}
+
+// DiskInfo describes one mounted filesystem and, when readable, the SMART
+// health of the block device backing it.
+type DiskInfo struct {
+	Device      string
+	Mountpoint  string
+	Fstype      string
+	Total       uint64
+	Free        uint64
+	Used        uint64
+	UsedPercent float64
+	ReadBytes   uint64
+	WriteBytes  uint64
+	SMART       *SMARTInfo // nil when SMART data is unavailable
+}
+
+// Disks enumerates mounted filesystems and pairs each with its usage and,
+// when run as root on Linux, its SMART health.
+func Disks() ([]DiskInfo, error) {
+
+	parts, err := disk.Partitions(false)
+	if err != nil {
+		return nil, err
+	}
+
+	ioCounters, err := disk.IOCounters()
+	if err != nil {
+		ioCounters = nil
+	}
+
+	var disks []DiskInfo
+	for _, p := range parts {
+		usage, err := disk.Usage(p.Mountpoint)
+		if err != nil {
+			// A mount can disappear between Partitions and Usage (race
+			// with unmount); skip it rather than fail the whole call.
+			continue
+		}
+
+		d := DiskInfo{
+			Device:      p.Device,
+			Mountpoint:  p.Mountpoint,
+			Fstype:      p.Fstype,
+			Total:       usage.Total,
+			Free:        usage.Free,
+			Used:        usage.Used,
+			UsedPercent: usage.UsedPercent,
+		}
+
+		if io, ok := ioCounters[filepath.Base(p.Device)]; ok {
+			d.ReadBytes = io.ReadBytes
+			d.WriteBytes = io.WriteBytes
+		}
+
+		d.SMART = readSMART(p.Device)
+
+		disks = append(disks, d)
+	}
+
+	return disks, nil
+}
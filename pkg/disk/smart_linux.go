@@ -0,0 +1,61 @@
+//go:build linux
+
+package disk
+
+import "github.com/anatol/smart.go"
+
+// readSMART opens the block device backing path and reads its SMART
+// attributes. It returns nil rather than an error whenever SMART data isn't
+// available, since that's the common case (non-root or a bus smart.go
+// doesn't support).
+func readSMART(path string) *SMARTInfo {
+
+	dev, err := smart.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer dev.Close()
+
+	info := &SMARTInfo{Healthy: true}
+	read := false
+
+	if ga, err := dev.ReadGenericAttributes(); err == nil {
+		read = true
+		info.TemperatureC = ga.Temperature
+		info.PowerOnHours = ga.PowerOnHours
+		info.PowerCycles = ga.PowerCycles
+	}
+
+	switch d := dev.(type) {
+	case *smart.SataDevice:
+		page, err := d.ReadSMARTData()
+		if err != nil {
+			break
+		}
+		read = true
+		if attr, ok := page.Attrs[5]; ok {
+			info.ReallocatedSectors = attr.ValueRaw
+			info.Healthy = info.Healthy && attr.ValueRaw == 0
+		}
+		if attr, ok := page.Attrs[177]; ok {
+			info.WearLeveling = uint64(attr.Current)
+		} else if attr, ok := page.Attrs[233]; ok {
+			info.WearLeveling = uint64(attr.Current)
+		}
+	case *smart.NVMeDevice:
+		log, err := d.ReadSMART()
+		if err != nil {
+			break
+		}
+		read = true
+		info.Healthy = log.CritWarning == 0
+		if log.PercentUsed <= 100 {
+			info.WearLeveling = 100 - uint64(log.PercentUsed)
+		}
+	}
+
+	if !read {
+		return nil
+	}
+	return info
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package disk
+
+// readSMART always reports SMART data as unavailable: smart.go's device
+// backends in this build are Linux-only (ioctl-based), so every other
+// platform degrades gracefully to usage-only DiskInfo.
+func readSMART(path string) *SMARTInfo {
+	return nil
+}
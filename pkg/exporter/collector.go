@@ -0,0 +1,113 @@
+// Package exporter exposes pcinfo's metrics as Prometheus series via a
+// prometheus.Collector.
+package exporter
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	psnet "github.com/shirou/gopsutil/v3/net"
+
+	"github.com/maliasadi/go-pcinfo/pkg/pcinfo"
+)
+
+// Collector implements prometheus.Collector, calling into pcinfo on every
+// scrape rather than caching, so it behaves correctly under Prometheus's
+// pull semantics.
+type Collector struct {
+	buildInfo *prometheus.Desc
+
+	load1, load5, load15   *prometheus.Desc
+	memTotal, memFree      *prometheus.Desc
+	memBuffer              *prometheus.Desc
+	swapTotal, swapFree    *prometheus.Desc
+	procs                  *prometheus.Desc
+	uptime                 *prometheus.Desc
+	cpuMhz                 *prometheus.Desc
+	netRxBytes, netTxBytes *prometheus.Desc
+}
+
+// NewCollector creates a Collector that reports pcinfo's metrics.
+func NewCollector() *Collector {
+	return &Collector{
+		buildInfo: prometheus.NewDesc(
+			"pcinfo_build_info", "Build information about pcinfo.",
+			[]string{"goos", "goarch", "hostname"}, nil),
+		load1:  prometheus.NewDesc("pcinfo_load1", "1 minute load average.", nil, nil),
+		load5:  prometheus.NewDesc("pcinfo_load5", "5 minute load average.", nil, nil),
+		load15: prometheus.NewDesc("pcinfo_load15", "15 minute load average.", nil, nil),
+		memTotal: prometheus.NewDesc(
+			"pcinfo_memory_total_bytes", "Total usable main memory.", nil, nil),
+		memFree: prometheus.NewDesc(
+			"pcinfo_memory_free_bytes", "Available memory.", nil, nil),
+		memBuffer: prometheus.NewDesc(
+			"pcinfo_memory_buffer_bytes", "Memory used by buffers.", nil, nil),
+		swapTotal: prometheus.NewDesc(
+			"pcinfo_swap_total_bytes", "Total swap space.", nil, nil),
+		swapFree: prometheus.NewDesc(
+			"pcinfo_swap_free_bytes", "Swap space still available.", nil, nil),
+		procs:  prometheus.NewDesc("pcinfo_procs", "Number of current processes.", nil, nil),
+		uptime: prometheus.NewDesc("pcinfo_uptime_seconds", "Seconds since boot.", nil, nil),
+		cpuMhz: prometheus.NewDesc(
+			"pcinfo_cpu_mhz", "CPU clock speed in MHz.", []string{"cpu", "vendor"}, nil),
+		netRxBytes: prometheus.NewDesc(
+			"pcinfo_net_rx_bytes", "Bytes received on a network interface.", []string{"iface"}, nil),
+		netTxBytes: prometheus.NewDesc(
+			"pcinfo_net_tx_bytes", "Bytes sent on a network interface.", []string{"iface"}, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.buildInfo
+	ch <- c.load1
+	ch <- c.load5
+	ch <- c.load15
+	ch <- c.memTotal
+	ch <- c.memFree
+	ch <- c.memBuffer
+	ch <- c.swapTotal
+	ch <- c.swapFree
+	ch <- c.procs
+	ch <- c.uptime
+	ch <- c.cpuMhz
+	ch <- c.netRxBytes
+	ch <- c.netTxBytes
+}
+
+// Collect implements prometheus.Collector. It is called once per scrape and
+// reads every metric fresh: host, load, memory and CPU metrics from pcinfo,
+// network byte counters from gopsutil's net package.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+
+	goos, goarch := pcinfo.OS()
+	hostname, _ := pcinfo.Hostname()
+	ch <- prometheus.MustNewConstMetric(c.buildInfo, prometheus.GaugeValue, 1, goos, goarch, hostname)
+
+	if sys, err := pcinfo.Sysinfo(); err == nil {
+		ch <- prometheus.MustNewConstMetric(c.load1, prometheus.GaugeValue, sys.Loads[0])
+		ch <- prometheus.MustNewConstMetric(c.load5, prometheus.GaugeValue, sys.Loads[1])
+		ch <- prometheus.MustNewConstMetric(c.load15, prometheus.GaugeValue, sys.Loads[2])
+		ch <- prometheus.MustNewConstMetric(c.memTotal, prometheus.GaugeValue, float64(sys.TotalRam*pcinfo.MemUnit))
+		ch <- prometheus.MustNewConstMetric(c.memFree, prometheus.GaugeValue, float64(sys.FreeRam*pcinfo.MemUnit))
+		ch <- prometheus.MustNewConstMetric(c.memBuffer, prometheus.GaugeValue, float64(sys.BufferRam*pcinfo.MemUnit))
+		ch <- prometheus.MustNewConstMetric(c.swapTotal, prometheus.GaugeValue, float64(sys.TotalSwap*pcinfo.MemUnit))
+		ch <- prometheus.MustNewConstMetric(c.swapFree, prometheus.GaugeValue, float64(sys.FreeSwap*pcinfo.MemUnit))
+		ch <- prometheus.MustNewConstMetric(c.procs, prometheus.GaugeValue, float64(sys.Procs))
+		ch <- prometheus.MustNewConstMetric(c.uptime, prometheus.GaugeValue, float64(sys.UpTime))
+	}
+
+	if cpus, err := pcinfo.CPUInfo(); err == nil {
+		for _, cpu := range cpus {
+			ch <- prometheus.MustNewConstMetric(c.cpuMhz, prometheus.GaugeValue, cpu.Mhz,
+				strconv.Itoa(int(cpu.CPU)), cpu.VendorID)
+		}
+	}
+
+	if counters, err := psnet.IOCounters(true); err == nil {
+		for _, s := range counters {
+			ch <- prometheus.MustNewConstMetric(c.netRxBytes, prometheus.GaugeValue, float64(s.BytesRecv), s.Name)
+			ch <- prometheus.MustNewConstMetric(c.netTxBytes, prometheus.GaugeValue, float64(s.BytesSent), s.Name)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Package framer implements a small framing goroutine modeled on Nomad's
+// StreamFramer (client/lib/streamframer): callers Send data and the Framer
+// forwards it as its own Frame as soon as it arrives, resetting the
+// heartbeat window; a heartbeat Frame is only emitted once that window
+// elapses with no Send, so heartbeats fire on genuine idle periods rather
+// than racing a fixed-phase ticker against the caller's send cadence.
+package framer
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrFramerShutdown is returned by Send once the Framer has been destroyed.
+var ErrFramerShutdown = errors.New("framer: shutdown")
+
+// Frame is one unit of output delivered on a Framer's out channel.
+type Frame struct {
+	Data      []byte
+	Heartbeat bool // true when no data arrived during this window
+}
+
+// Framer forwards each Send as its own Frame and emits a heartbeat Frame
+// whenever heartbeatWindow elapses without a Send.
+type Framer struct {
+	out       chan<- *Frame
+	window    time.Duration
+	heartbeat *time.Timer
+
+	sendCh     chan []byte
+	shutdownCh chan struct{}
+	exitCh     chan struct{}
+
+	l       sync.Mutex
+	err     error
+	running bool
+}
+
+// NewFramer creates a Framer that emits a heartbeat Frame after
+// heartbeatWindow of silence and writes Frames to out.
+func NewFramer(out chan<- *Frame, heartbeatWindow time.Duration) *Framer {
+	return &Framer{
+		out:        out,
+		window:     heartbeatWindow,
+		heartbeat:  time.NewTimer(heartbeatWindow),
+		sendCh:     make(chan []byte, 32),
+		shutdownCh: make(chan struct{}),
+		exitCh:     make(chan struct{}),
+	}
+}
+
+// Destroy stops the Framer and blocks until its Run goroutine has exited.
+func (f *Framer) Destroy() {
+	f.l.Lock()
+	alreadyShutdown := f.isShutdown()
+	if !alreadyShutdown {
+		close(f.shutdownCh)
+	}
+	running := f.running
+	f.l.Unlock()
+
+	f.heartbeat.Stop()
+	if running && !alreadyShutdown {
+		<-f.exitCh
+	}
+}
+
+func (f *Framer) isShutdown() bool {
+	select {
+	case <-f.shutdownCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExitCh returns a channel that is closed once Run has returned, which
+// happens only after Destroy is called.
+func (f *Framer) ExitCh() <-chan struct{} {
+	return f.exitCh
+}
+
+// Err returns the error recorded with setErr, if any. Run stops only on
+// Destroy and records no error, so Err returns nil after a Destroy.
+func (f *Framer) Err() error {
+	f.l.Lock()
+	defer f.l.Unlock()
+	return f.err
+}
+
+func (f *Framer) setErr(err error) {
+	f.l.Lock()
+	f.err = err
+	f.l.Unlock()
+}
+
+// Send queues data to be forwarded as its own Frame and resets the
+// heartbeat window.
+func (f *Framer) Send(data []byte) error {
+	select {
+	case <-f.shutdownCh:
+		return ErrFramerShutdown
+	default:
+	}
+
+	select {
+	case f.sendCh <- data:
+		return nil
+	case <-f.shutdownCh:
+		return ErrFramerShutdown
+	}
+}
+
+// Run forwards each incoming Send as its own Frame and writes a heartbeat
+// Frame whenever a full window passes without one. It must be called in
+// its own goroutine and runs until Destroy is called; a write to out that
+// blocks is abandoned when Destroy is called.
+func (f *Framer) Run() {
+	f.l.Lock()
+	if f.running {
+		f.l.Unlock()
+		return
+	}
+	f.running = true
+	f.l.Unlock()
+
+	defer close(f.exitCh)
+
+	for {
+		select {
+		case <-f.shutdownCh:
+			return
+		case data := <-f.sendCh:
+			f.resetHeartbeat()
+			select {
+			case f.out <- &Frame{Data: data}:
+			case <-f.shutdownCh:
+				return
+			}
+		case <-f.heartbeat.C:
+			f.heartbeat.Reset(f.window)
+			select {
+			case f.out <- &Frame{Heartbeat: true}:
+			case <-f.shutdownCh:
+				return
+			}
+		}
+	}
+}
+
+// resetHeartbeat restarts the heartbeat window after a Send, draining a
+// pending fire so it doesn't race the next window.
+func (f *Framer) resetHeartbeat() {
+	if !f.heartbeat.Stop() {
+		select {
+		case <-f.heartbeat.C:
+		default:
+		}
+	}
+	f.heartbeat.Reset(f.window)
+}
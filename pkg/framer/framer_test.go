@@ -0,0 +1,66 @@
+package framer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFramerSendProducesDataFrame(t *testing.T) {
+	out := make(chan *Frame, 8)
+	fr := NewFramer(out, 100*time.Millisecond)
+	go fr.Run()
+	defer fr.Destroy()
+
+	if err := fr.Send([]byte("hello")); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	select {
+	case frame := <-out:
+		if frame.Heartbeat {
+			t.Fatalf("got heartbeat frame, want data frame")
+		}
+		if string(frame.Data) != "hello" {
+			t.Fatalf("frame.Data = %q, want %q", frame.Data, "hello")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for data frame")
+	}
+}
+
+func TestFramerIdleProducesHeartbeat(t *testing.T) {
+	out := make(chan *Frame, 8)
+	fr := NewFramer(out, 50*time.Millisecond)
+	go fr.Run()
+	defer fr.Destroy()
+
+	select {
+	case frame := <-out:
+		if !frame.Heartbeat {
+			t.Fatalf("got data frame, want heartbeat frame")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for heartbeat frame")
+	}
+}
+
+func TestFramerDestroyDoesNotDeadlockOnBlockedOut(t *testing.T) {
+	out := make(chan *Frame) // unbuffered and never drained
+	fr := NewFramer(out, 10*time.Millisecond)
+	go fr.Run()
+
+	// Give Run a chance to block trying to write a heartbeat to out.
+	time.Sleep(100 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		fr.Destroy()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Destroy deadlocked with a blocked out channel")
+	}
+}
@@ -0,0 +1,63 @@
+//go:build linux
+
+package host
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ResourceLimits is the resource limits a cgroup enforces on this process.
+// A zero field means no limit is enforced (or none could be read).
+type ResourceLimits struct {
+	CPUQuota         float64 // effective CPU core count, e.g. 1.5
+	MemoryLimitBytes uint64
+}
+
+// cgroupV1MemUnlimited is the threshold at or above which a
+// memory.limit_in_bytes value is treated as "no limit". The value reported
+// when no limit is set is PAGE_COUNTER_MAX rounded down to a page boundary,
+// which lies above this threshold and is not a real byte count.
+const cgroupV1MemUnlimited = 1 << 62
+
+// CgroupLimits reads the CPU quota and memory limit enforced on this
+// process's cgroup, preferring cgroup v2's unified hierarchy and falling
+// back to cgroup v1.
+func CgroupLimits() (ResourceLimits, error) {
+
+	var limits ResourceLimits
+
+	if contents, err := readFileString("/sys/fs/cgroup/cpu.max"); err == nil {
+		fields := strings.Fields(strings.TrimSpace(contents))
+		if len(fields) == 2 && fields[0] != "max" {
+			quota, qerr := strconv.ParseFloat(fields[0], 64)
+			period, perr := strconv.ParseFloat(fields[1], 64)
+			if qerr == nil && perr == nil && period > 0 {
+				limits.CPUQuota = quota / period
+			}
+		}
+	} else if quota, qerr := readFileString("/sys/fs/cgroup/cpu/cpu.cfs_quota_us"); qerr == nil {
+		if period, perr := readFileString("/sys/fs/cgroup/cpu/cpu.cfs_period_us"); perr == nil {
+			q, qerr := strconv.ParseInt(strings.TrimSpace(quota), 10, 64)
+			p, perr := strconv.ParseInt(strings.TrimSpace(period), 10, 64)
+			if qerr == nil && perr == nil && q > 0 && p > 0 {
+				limits.CPUQuota = float64(q) / float64(p)
+			}
+		}
+	}
+
+	if contents, err := readFileString("/sys/fs/cgroup/memory.max"); err == nil {
+		s := strings.TrimSpace(contents)
+		if s != "max" {
+			if v, verr := strconv.ParseUint(s, 10, 64); verr == nil {
+				limits.MemoryLimitBytes = v
+			}
+		}
+	} else if contents, err := readFileString("/sys/fs/cgroup/memory/memory.limit_in_bytes"); err == nil {
+		if v, verr := strconv.ParseUint(strings.TrimSpace(contents), 10, 64); verr == nil && v < cgroupV1MemUnlimited {
+			limits.MemoryLimitBytes = v
+		}
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package host
+
+// ResourceLimits is the resource limits a cgroup enforces on this process.
+// A zero field means no limit is enforced (or none could be read).
+type ResourceLimits struct {
+	CPUQuota         float64
+	MemoryLimitBytes uint64
+}
+
+// CgroupLimits always reports no limits: cgroups are a Linux-only concept.
+func CgroupLimits() (ResourceLimits, error) {
+	return ResourceLimits{}, nil
+}
@@ -0,0 +1,4 @@
+// Package host detects whether the process is running inside a container
+// or VM, and, when it is, the cgroup-enforced resource limits that apply
+// to it.
+package host
@@ -0,0 +1,76 @@
+//go:build linux
+
+package host
+
+import (
+	"os"
+	"strings"
+)
+
+// Virtualization reports whether this process is running inside a
+// container or VM, modeled on gopsutil's host.Virtualization. It inspects
+// /proc/1/cgroup, /proc/self/mountinfo, /sys/class/dmi/id/product_name,
+// and the usual container marker files.
+func Virtualization() (system, role string, err error) {
+
+	if pathExists("/.dockerenv") || pathExists("/run/.containerenv") {
+		return "docker", "guest", nil
+	}
+
+	if contents, rerr := readFileString("/proc/1/cgroup"); rerr == nil {
+		switch {
+		case strings.Contains(contents, "docker"):
+			return "docker", "guest", nil
+		case strings.Contains(contents, "kubepods"):
+			return "kubepods", "guest", nil
+		case strings.Contains(contents, "lxc"):
+			return "lxc", "guest", nil
+		case strings.Contains(contents, "nspawn"):
+			return "systemd-nspawn", "guest", nil
+		}
+	}
+
+	if contents, rerr := readFileString("/proc/self/mountinfo"); rerr == nil && strings.Contains(contents, "lxcfs") {
+		return "lxc", "guest", nil
+	}
+
+	if isWSL() {
+		return "wsl", "guest", nil
+	}
+
+	if product, rerr := readFileString("/sys/class/dmi/id/product_name"); rerr == nil {
+		product = strings.TrimSpace(product)
+		switch {
+		case strings.Contains(product, "KVM"):
+			return "kvm", "guest", nil
+		case strings.Contains(product, "VMware"):
+			return "vmware", "guest", nil
+		case strings.HasPrefix(product, "Xen"):
+			return "xen", "guest", nil
+		}
+	}
+
+	return "", "host", nil
+}
+
+func isWSL() bool {
+	osrelease, err := readFileString("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return false
+	}
+	lower := strings.ToLower(osrelease)
+	return strings.Contains(lower, "microsoft") || strings.Contains(lower, "wsl")
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func readFileString(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package host
+
+// Virtualization always reports bare metal: the container/VM markers this
+// package looks for (cgroup, dockerenv, DMI product name) are Linux-specific.
+func Virtualization() (system, role string, err error) {
+	return "", "host", nil
+}
@@ -0,0 +1,42 @@
+//go:build linux
+
+package pcinfo
+
+import (
+	"math"
+
+	sysconf "github.com/tklauser/go-sysconf"
+	"github.com/tklauser/numcpus"
+
+	"github.com/maliasadi/go-pcinfo/pkg/host"
+)
+
+// adjustCoreCounts overrides each CPUStat's Cores with the effective core
+// count enforced by a cgroup CPU quota, so a container reports what it can
+// actually schedule instead of the host's physical per-package count. It
+// is a no-op when no cgroup quota is in effect (bare metal, or a container
+// started without one), leaving Cores as gopsutil reported it.
+func adjustCoreCounts(stats []CPUStat) {
+
+	limits, lerr := host.CgroupLimits()
+	if lerr != nil || limits.CPUQuota <= 0 {
+		return
+	}
+
+	n, err := numcpus.GetOnline()
+	if err != nil {
+		v, serr := sysconf.Sysconf(sysconf.SC_NPROCESSORS_ONLN)
+		if serr != nil {
+			return
+		}
+		n = int(v)
+	}
+
+	if quota := int(math.Ceil(limits.CPUQuota)); quota < n {
+		n = quota
+	}
+
+	for i := range stats {
+		stats[i].Cores = int32(n)
+	}
+}
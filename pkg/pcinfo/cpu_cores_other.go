@@ -0,0 +1,7 @@
+//go:build !linux
+
+package pcinfo
+
+// adjustCoreCounts is a no-op outside Linux: gopsutil's reported core
+// counts are used as-is.
+func adjustCoreCounts(stats []CPUStat) {}
@@ -0,0 +1,54 @@
+//go:build darwin && arm64
+
+package pcinfo
+
+import (
+	m1cpu "github.com/shoenig/go-m1cpu"
+	"golang.org/x/sys/unix"
+)
+
+// armFeatureFlags are the ARM FEAT_* capability flags probed via the
+// hw.optional.arm.* sysctl namespace.
+var armFeatureFlags = []string{
+	"FEAT_FCMA", "FEAT_FHM", "FEAT_FP16", "FEAT_DotProd", "FEAT_JSCVT",
+	"FEAT_LSE", "FEAT_SHA256", "FEAT_SHA512", "FEAT_SHA3", "FEAT_AES",
+	"FEAT_PMULL", "FEAT_BF16", "FEAT_I8MM", "FEAT_DPB", "FEAT_DPB2",
+	"FEAT_FlagM", "FEAT_FlagM2",
+}
+
+// platformCPUInfo reports Apple Silicon CPU details via go-m1cpu, since
+// there's no /proc/cpuinfo on Darwin and gopsutil's cpu.Info() falls back
+// to near-empty records (no model name, 0 MHz, no flags) on arm64.
+func platformCPUInfo() ([]CPUStat, error) {
+	if !m1cpu.IsAppleSilicon() {
+		return nil, nil
+	}
+
+	pCores := int32(m1cpu.PCoreCount())
+	eCores := int32(m1cpu.ECoreCount())
+
+	stat := CPUStat{
+		VendorID:  "Apple",
+		ModelName: m1cpu.ModelName(),
+		Mhz:       m1cpu.PCoreGHz() * 1000,
+		EMhz:      m1cpu.ECoreGHz() * 1000,
+		Cores:     pCores + eCores,
+		PCores:    pCores,
+		ECores:    eCores,
+		Flags:     armFlags(),
+	}
+
+	return []CPUStat{stat}, nil
+}
+
+// armFlags probes each of armFeatureFlags via sysctl, returning the names
+// of the ones this CPU supports.
+func armFlags() []string {
+	var flags []string
+	for _, name := range armFeatureFlags {
+		if v, err := unix.SysctlUint32("hw.optional.arm." + name); err == nil && v == 1 {
+			flags = append(flags, name)
+		}
+	}
+	return flags
+}
@@ -0,0 +1,9 @@
+//go:build !(darwin && arm64)
+
+package pcinfo
+
+// platformCPUInfo returns (nil, nil) on platforms without a specialized CPU
+// detection path, telling CPUInfo to fall back to gopsutil.
+func platformCPUInfo() ([]CPUStat, error) {
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+// Package pcinfo is a cross-platform library for reading host system
+// information: uptime, load average, memory, swap, CPU, and network
+// interfaces. Platform-specific backends live in the sysinfo_*.go files
+// and are selected at build time via build tags.
+package pcinfo
+
+import (
+	"net"
+	"os"
+	"runtime"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+)
+
+const (
+	LoadsScale = 1 << 16     // 2^16
+	MemUnit    = 1024 * 1024 // MB
+)
+
+// SysInfo is the go version of the "sysinfo" struct,
+// for Linux versions Since 2.3.23 (i386) and  2.3.48 (all architectures).
+// Reference: http://man7.org/linux/man-pages/man2/sysinfo.2.html
+//
+// On non-Linux platforms the fields are populated from gopsutil's
+// host/mem/load backends instead of the raw syscall, so fields that
+// have no equivalent on a given OS (e.g. SharedRam, TotalHigh) are left
+// at zero.
+type SysInfo struct {
+	UpTime    int64      // Seconds since boot
+	Loads     [3]float64 // 1, 5, and 15 minute load averages
+	TotalRam  uint64     // Total usable main memory size
+	FreeRam   uint64     // Available memory size
+	SharedRam uint64     // Amount of shared memory
+	BufferRam uint64     // Memory used by buffers
+	TotalSwap uint64     // Total swap space size
+	FreeSwap  uint64     // Swap space still available
+	Procs     uint16     // Number of current processes
+	TotalHigh uint64     // Total high memory size
+	FreeHigh  uint64     // Available high memory size
+}
+
+// Hostname is like gethostname() in unistd.h: it returns the host's name.
+func Hostname() (string, error) {
+	return os.Hostname()
+}
+
+// OS returns the runtime.GOOS and runtime.GOARCH variables as strings.
+func OS() (string, string) {
+	return runtime.GOOS, runtime.GOARCH
+}
+
+// CPUStat describes one logical CPU. It mirrors gopsutil's cpu.InfoStat
+// and adds PCores/ECores for hybrid (performance/efficiency) CPUs.
+type CPUStat struct {
+	CPU        int32
+	VendorID   string
+	Family     string
+	Model      string
+	Stepping   int32
+	PhysicalID string
+	CoreID     string
+
+	// Cores is gopsutil's per-package core count, e.g. 8 on an 8-core/
+	// 16-thread single socket. On Linux, under a cgroup CPU quota, it is
+	// instead the effective core count that quota allows this process to
+	// schedule (see adjustCoreCounts) — the quantity a constrained
+	// workload actually cares about.
+	Cores int32
+
+	ModelName string
+	Mhz       float64
+	CacheSize int32
+	Flags     []string
+	Microcode string
+
+	// PCores and ECores are the performance- and efficiency-core counts on
+	// hybrid CPUs such as Apple Silicon. They are zero on symmetric CPUs.
+	PCores int32
+	ECores int32
+
+	// EMhz is the efficiency-core nominal frequency on hybrid CPUs such as
+	// Apple Silicon; Mhz holds the performance-core frequency in that case.
+	// Zero on symmetric CPUs.
+	EMhz float64
+}
+
+// CPUInfo is like sysinfo in sys/sysinfo.h, returning the cpu information
+// from /proc/cpuinfo (or its platform equivalent).
+// Reference: https://github.com/shirou/gopsutil
+func CPUInfo() ([]CPUStat, error) {
+
+	if stats, err := platformCPUInfo(); stats != nil || err != nil {
+		return stats, err
+	}
+
+	infos, err := cpu.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]CPUStat, len(infos))
+	for i, info := range infos {
+		stats[i] = CPUStat{
+			CPU:        info.CPU,
+			VendorID:   info.VendorID,
+			Family:     info.Family,
+			Model:      info.Model,
+			Stepping:   info.Stepping,
+			PhysicalID: info.PhysicalID,
+			CoreID:     info.CoreID,
+			Cores:      info.Cores,
+			ModelName:  info.ModelName,
+			Mhz:        info.Mhz,
+			CacheSize:  info.CacheSize,
+			Flags:      info.Flags,
+			Microcode:  info.Microcode,
+		}
+	}
+
+	adjustCoreCounts(stats)
+
+	return stats, nil
+}
+
+// Interfaces is like ifconfig, returning the network interfaces of
+// /sys/class/net.
+// Reference:  https://golang.org/pkg/net/
+func Interfaces() ([]net.Interface, error) {
+	return net.Interfaces()
+}
@@ -0,0 +1,10 @@
+//go:build aix
+
+package pcinfo
+
+// Sysinfo is like sysinfo() in sys/sysinfo.h: it returns system information
+// as a SysInfo value. AIX has no sysinfo(2) equivalent, so this is backed by
+// gopsutil.
+func Sysinfo() (SysInfo, error) {
+	return sysInfoFromGopsutil()
+}
@@ -0,0 +1,50 @@
+//go:build darwin || freebsd || windows || plan9 || aix
+
+package pcinfo
+
+import (
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/load"
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+// sysInfoFromGopsutil fills a SysInfo from gopsutil's host/mem/load
+// backends. It is shared by the platforms with no direct sysinfo(2)
+// equivalent that this package builds for: Darwin, FreeBSD, Windows,
+// plan9 and AIX.
+func sysInfoFromGopsutil() (SysInfo, error) {
+
+	sys_obj := SysInfo{}
+
+	uptime, err := host.Uptime()
+	if err != nil {
+		return sys_obj, err
+	}
+	sys_obj.UpTime = int64(uptime)
+
+	if avg, err := load.Avg(); err == nil {
+		sys_obj.Loads[0] = avg.Load1
+		sys_obj.Loads[1] = avg.Load5
+		sys_obj.Loads[2] = avg.Load15
+	}
+
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		return sys_obj, err
+	}
+	sys_obj.TotalRam = vm.Total / MemUnit
+	sys_obj.FreeRam = vm.Free / MemUnit
+	sys_obj.SharedRam = vm.Shared / MemUnit
+	sys_obj.BufferRam = vm.Buffers / MemUnit
+
+	if sm, err := mem.SwapMemory(); err == nil {
+		sys_obj.TotalSwap = sm.Total / MemUnit
+		sys_obj.FreeSwap = sm.Free / MemUnit
+	}
+
+	if info, err := host.Info(); err == nil {
+		sys_obj.Procs = uint16(info.Procs)
+	}
+
+	return sys_obj, nil
+}
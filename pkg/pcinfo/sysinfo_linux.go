@@ -0,0 +1,31 @@
+//go:build linux
+
+package pcinfo
+
+import "syscall"
+
+// Sysinfo is like sysinfo() in sys/sysinfo.h: it returns system information
+// as a SysInfo value.
+func Sysinfo() (SysInfo, error) {
+
+	sys_obj := SysInfo{}
+	sys_ref := &syscall.Sysinfo_t{}
+	if err := syscall.Sysinfo(sys_ref); err != nil {
+		return sys_obj, err
+	}
+	sys_obj.UpTime = sys_ref.Uptime
+	sys_obj.Loads[0] = float64(sys_ref.Loads[0]) / LoadsScale
+	sys_obj.Loads[1] = float64(sys_ref.Loads[1]) / LoadsScale
+	sys_obj.Loads[2] = float64(sys_ref.Loads[2]) / LoadsScale
+	sys_obj.TotalRam = sys_ref.Totalram / MemUnit
+	sys_obj.FreeRam = sys_ref.Freeram / MemUnit
+	sys_obj.SharedRam = sys_ref.Sharedram / MemUnit
+	sys_obj.BufferRam = sys_ref.Bufferram / MemUnit
+	sys_obj.TotalSwap = sys_ref.Totalswap / MemUnit
+	sys_obj.FreeSwap = sys_ref.Freeswap / MemUnit
+	sys_obj.Procs = sys_ref.Procs
+	sys_obj.TotalHigh = sys_ref.Totalhigh / MemUnit
+	sys_obj.FreeHigh = sys_ref.Freehigh / MemUnit
+
+	return sys_obj, nil
+}
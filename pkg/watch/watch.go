@@ -0,0 +1,59 @@
+// Package watch samples system metrics for continuous monitoring: CPU
+// utilization, load, memory, and network/disk I/O counters.
+package watch
+
+import (
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/load"
+	"github.com/shirou/gopsutil/v3/mem"
+	psnet "github.com/shirou/gopsutil/v3/net"
+)
+
+// Snapshot is one sample of system metrics, suitable for serializing as a
+// streaming frame.
+type Snapshot struct {
+	Timestamp  time.Time                      `json:"timestamp"`
+	CPUPercent []float64                      `json:"cpu_percent"`
+	Load1      float64                        `json:"load1"`
+	Load5      float64                        `json:"load5"`
+	Load15     float64                        `json:"load15"`
+	MemTotal   uint64                         `json:"mem_total_bytes"`
+	MemUsed    uint64                         `json:"mem_used_bytes"`
+	NetIO      []psnet.IOCountersStat         `json:"net_io"`
+	DiskIO     map[string]disk.IOCountersStat `json:"disk_io"`
+}
+
+// Sample gathers one Snapshot. cpuInterval is passed straight to
+// cpu.Percent, so Sample blocks for that long to measure CPU utilization
+// over the interval rather than returning a since-boot average.
+func Sample(cpuInterval time.Duration) (*Snapshot, error) {
+
+	snap := &Snapshot{Timestamp: time.Now()}
+
+	cpuPercent, err := cpu.Percent(cpuInterval, false)
+	if err != nil {
+		return nil, err
+	}
+	snap.CPUPercent = cpuPercent
+
+	if avg, err := load.Avg(); err == nil {
+		snap.Load1, snap.Load5, snap.Load15 = avg.Load1, avg.Load5, avg.Load15
+	}
+
+	if vm, err := mem.VirtualMemory(); err == nil {
+		snap.MemTotal, snap.MemUsed = vm.Total, vm.Used
+	}
+
+	if netIO, err := psnet.IOCounters(true); err == nil {
+		snap.NetIO = netIO
+	}
+
+	if diskIO, err := disk.IOCounters(); err == nil {
+		snap.DiskIO = diskIO
+	}
+
+	return snap, nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/maliasadi/go-pcinfo/pkg/exporter"
+)
+
+// runServe registers pcinfo's Prometheus collector and serves /metrics on
+// addr until the process is terminated.
+func runServe(addr string) error {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(exporter.NewCollector())
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	return http.ListenAndServe(addr, mux)
+}
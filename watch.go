@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/maliasadi/go-pcinfo/pkg/framer"
+	"github.com/maliasadi/go-pcinfo/pkg/watch"
+)
+
+// runWatch streams newline-delimited JSON frames, one per interval, to
+// stdout or, when listen is non-empty, to a single TCP client.
+func runWatch(interval time.Duration, format string, listen string) error {
+
+	if format != "json" {
+		return fmt.Errorf("unsupported -format %q: only \"json\" is supported", format)
+	}
+
+	out := io.Writer(os.Stdout)
+	if listen != "" {
+		ln, err := net.Listen("tcp", listen)
+		if err != nil {
+			return err
+		}
+		defer ln.Close()
+		log.Printf("watch: listening on %s", listen)
+
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		out = conn
+	}
+
+	// The heartbeat window must outlast the sample cadence with slack, or
+	// the two race every cycle: a heartbeat can fire moments before a
+	// sample taken in the same window lands, and vice versa. Each Sample
+	// blocks for one interval and the window is two intervals, so a
+	// heartbeat only fires when a sample has taken more than twice its
+	// interval, i.e. when sampling has genuinely stalled.
+	frameCh := make(chan *framer.Frame, 8)
+	fr := framer.NewFramer(frameCh, 2*interval)
+	go fr.Run()
+	defer fr.Destroy()
+
+	sampleErrCh := make(chan error, 1)
+	go func() {
+		for {
+			snap, err := watch.Sample(interval)
+			if err != nil {
+				sampleErrCh <- err
+				return
+			}
+			data, err := json.Marshal(snap)
+			if err != nil {
+				sampleErrCh <- err
+				return
+			}
+			if err := fr.Send(data); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case frame, ok := <-frameCh:
+			if !ok {
+				return fr.Err()
+			}
+			data := frame.Data
+			if frame.Heartbeat {
+				data, _ = json.Marshal(struct {
+					Timestamp time.Time `json:"timestamp"`
+					Heartbeat bool      `json:"heartbeat"`
+				}{time.Now(), true})
+			}
+			data = append(data, '\n')
+			if _, err := out.Write(data); err != nil {
+				return err
+			}
+		case err := <-sampleErrCh:
+			return err
+		case <-fr.ExitCh():
+			return fr.Err()
+		}
+	}
+}